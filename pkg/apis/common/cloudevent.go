@@ -0,0 +1,170 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CloudEventsSpecVersion is the CloudEvents specification version emitted by
+// ToCloudEvent.
+const CloudEventsSpecVersion = "1.0"
+
+// Event type constants identifying the gateway that produced a CloudEvent, one
+// per EventData type in this package that can be wrapped via ToCloudEvent.
+const (
+	AMQPEventType           = "com.blackrock.argo.amqp.message"
+	SNSEventType            = "com.blackrock.argo.sns.message"
+	SQSEventType            = "com.blackrock.argo.sqs.message"
+	AzureEventsHubEventType = "com.blackrock.argo.azureeventshub.message"
+	CalendarEventType       = "com.blackrock.argo.calendar.message"
+	EmitterEventType        = "com.blackrock.argo.emitter.message"
+	PubSubEventType         = "com.blackrock.argo.pubsub.message"
+	GithubEventType         = "com.blackrock.argo.github.message"
+	GitLabEventType         = "com.blackrock.argo.gitlab.message"
+	KafkaEventType          = "com.blackrock.argo.kafka.message"
+	MinioEventType          = "com.blackrock.argo.minio.notification"
+	MQTTEventType           = "com.blackrock.argo.mqtt.message"
+	NATSEventType           = "com.blackrock.argo.nats.message"
+	NSQEventType            = "com.blackrock.argo.nsq.message"
+	RedisEventType          = "com.blackrock.argo.redis.message"
+	ResourceEventType       = "com.blackrock.argo.resource.message"
+	WebhookEventType        = "com.blackrock.argo.webhook.message"
+	SSEEventType            = "com.blackrock.argo.sse.message"
+)
+
+// CloudEventEnvelope wraps any of the EventData types in this package in a
+// CloudEvents 1.0 compatible JSON envelope, so gateway output can be consumed
+// directly by any CloudEvents-aware system (Knative eventing, Kafka sinks,
+// etc.) without a custom transform.
+//
+// This type and ToCloudEvent are the shared wrapping primitive; each
+// gateway's dispatch path is responsible for calling ToCloudEvent with a
+// subject built from its own natural keys (Kafka topic/partition, MQTT
+// topic, Minio bucket/object, SQS queue, PubSub subscription, etc.) before
+// handing the result to a sensor — ToCloudEvent derives Type from the
+// EventData's concrete type. That per-gateway wiring is not present in this
+// tree.
+// +k8s:openapi-gen=true
+type CloudEventEnvelope struct {
+	// SpecVersion is the version of the CloudEvents specification this event
+	// conforms to.
+	SpecVersion string `json:"specversion"`
+	// ID uniquely identifies the event within the scope of its source.
+	ID string `json:"id"`
+	// Source identifies the gateway that produced the event.
+	Source string `json:"source"`
+	// Type describes the kind of event, e.g. com.blackrock.argo.kafka.message.
+	Type string `json:"type"`
+	// Subject identifies the subject of the event within the context of the
+	// source, e.g. the Kafka topic/partition or Minio bucket/object.
+	// +optional
+	Subject string `json:"subject,omitempty"`
+	// Time is the RFC3339 timestamp, in UTC, of when ToCloudEvent built the
+	// envelope; it is not taken from the wrapped EventData.
+	Time string `json:"time"`
+	// DataContentType is the media type of the data attribute value.
+	// +optional
+	DataContentType string `json:"datacontenttype,omitempty"`
+	// Data is the event payload, present when it is valid JSON.
+	// +optional
+	Data json.RawMessage `json:"data,omitempty"`
+	// DataBase64 is the event payload base64-encoded, present when the payload
+	// is raw bytes so binary data survives the JSON envelope.
+	// +optional
+	DataBase64 string `json:"data_base64,omitempty"`
+}
+
+// ToCloudEvent wraps payload in a CloudEvents 1.0 envelope and returns it
+// marshalled as JSON. Type is derived from payload's concrete type via
+// cloudEventType, so callers don't have to thread a Type constant through by
+// hand. If payload is a []byte, it is placed in data_base64 so binary bodies
+// survive the envelope; otherwise it is marshalled as JSON and placed in
+// data.
+func ToCloudEvent(source, subject string, payload interface{}) ([]byte, error) {
+	envelope := CloudEventEnvelope{
+		SpecVersion: CloudEventsSpecVersion,
+		ID:          uuid.New().String(),
+		Source:      source,
+		Type:        cloudEventType(payload),
+		Subject:     subject,
+		Time:        time.Now().UTC().Format(time.RFC3339),
+	}
+
+	if body, ok := payload.([]byte); ok {
+		envelope.DataContentType = "application/octet-stream"
+		envelope.DataBase64 = base64.StdEncoding.EncodeToString(body)
+	} else {
+		data, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		envelope.DataContentType = "application/json"
+		envelope.Data = data
+	}
+
+	return json.Marshal(envelope)
+}
+
+// cloudEventType returns the Type constant for payload's concrete EventData
+// type, or the empty string if payload isn't one of the EventData types
+// declared in this package (e.g. a raw []byte body).
+func cloudEventType(payload interface{}) string {
+	switch payload.(type) {
+	case AMQPEventData, *AMQPEventData:
+		return AMQPEventType
+	case SNSEventData, *SNSEventData:
+		return SNSEventType
+	case SQSEventData, *SQSEventData:
+		return SQSEventType
+	case AzureEventsHubEventData, *AzureEventsHubEventData:
+		return AzureEventsHubEventType
+	case CalendarEventData, *CalendarEventData:
+		return CalendarEventType
+	case EmitterEventData, *EmitterEventData:
+		return EmitterEventType
+	case PubSubEventData, *PubSubEventData:
+		return PubSubEventType
+	case GithubEventData, *GithubEventData:
+		return GithubEventType
+	case GitLabEventData, *GitLabEventData:
+		return GitLabEventType
+	case KafkaEventData, *KafkaEventData:
+		return KafkaEventType
+	case MinioEventData, *MinioEventData:
+		return MinioEventType
+	case MQTTEventData, *MQTTEventData:
+		return MQTTEventType
+	case NATSEventData, *NATSEventData:
+		return NATSEventType
+	case NSQEventData, *NSQEventData:
+		return NSQEventType
+	case RedisEventData, *RedisEventData:
+		return RedisEventType
+	case ResourceEventData, *ResourceEventData:
+		return ResourceEventType
+	case WebhookEventData, *WebhookEventData:
+		return WebhookEventType
+	case SSEEventData, *SSEEventData:
+		return SSEEventType
+	default:
+		return ""
+	}
+}
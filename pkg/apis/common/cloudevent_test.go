@@ -0,0 +1,120 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+)
+
+func TestToCloudEventJSONPayload(t *testing.T) {
+	out, err := ToCloudEvent("kafka-gateway", "my-topic/0", KafkaEventData{Topic: "my-topic"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var envelope CloudEventEnvelope
+	if err := json.Unmarshal(out, &envelope); err != nil {
+		t.Fatalf("unexpected error unmarshalling envelope: %v", err)
+	}
+
+	if envelope.SpecVersion != CloudEventsSpecVersion {
+		t.Errorf("specversion = %q, want %q", envelope.SpecVersion, CloudEventsSpecVersion)
+	}
+	if envelope.Source != "kafka-gateway" {
+		t.Errorf("source = %q, want kafka-gateway", envelope.Source)
+	}
+	if envelope.Type != KafkaEventType {
+		t.Errorf("type = %q, want %q", envelope.Type, KafkaEventType)
+	}
+	if envelope.Subject != "my-topic/0" {
+		t.Errorf("subject = %q, want my-topic/0", envelope.Subject)
+	}
+	if envelope.DataContentType != "application/json" {
+		t.Errorf("datacontenttype = %q, want application/json", envelope.DataContentType)
+	}
+	if len(envelope.Data) == 0 {
+		t.Errorf("expected data to be populated for a JSON payload")
+	}
+	if envelope.DataBase64 != "" {
+		t.Errorf("expected data_base64 to be empty for a JSON payload, got %q", envelope.DataBase64)
+	}
+	if envelope.ID == "" {
+		t.Errorf("expected id to be populated")
+	}
+	if envelope.Time == "" {
+		t.Errorf("expected time to be populated")
+	}
+}
+
+func TestToCloudEventBinaryPayload(t *testing.T) {
+	body := []byte("raw binary body")
+
+	out, err := ToCloudEvent("webhook-gateway", "", body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var envelope CloudEventEnvelope
+	if err := json.Unmarshal(out, &envelope); err != nil {
+		t.Fatalf("unexpected error unmarshalling envelope: %v", err)
+	}
+
+	if envelope.DataContentType != "application/octet-stream" {
+		t.Errorf("datacontenttype = %q, want application/octet-stream", envelope.DataContentType)
+	}
+	if len(envelope.Data) != 0 {
+		t.Errorf("expected data to be empty for a binary payload, got %s", envelope.Data)
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(envelope.DataBase64)
+	if err != nil {
+		t.Fatalf("data_base64 did not decode: %v", err)
+	}
+	if string(decoded) != string(body) {
+		t.Errorf("decoded data_base64 = %q, want %q", decoded, body)
+	}
+
+	// A raw []byte payload carries no EventData type information, so Type is
+	// left empty rather than guessed.
+	if envelope.Type != "" {
+		t.Errorf("type = %q, want empty for a raw []byte payload", envelope.Type)
+	}
+}
+
+func TestCloudEventTypeInference(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload interface{}
+		want    string
+	}{
+		{"Kafka value", KafkaEventData{}, KafkaEventType},
+		{"Kafka pointer", &KafkaEventData{}, KafkaEventType},
+		{"Minio value", MinioEventData{}, MinioEventType},
+		{"Webhook value", WebhookEventData{}, WebhookEventType},
+		{"SSE value", SSEEventData{}, SSEEventType},
+		{"unknown type", "not an EventData", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cloudEventType(tt.payload); got != tt.want {
+				t.Errorf("cloudEventType(%#v) = %q, want %q", tt.payload, got, tt.want)
+			}
+		})
+	}
+}
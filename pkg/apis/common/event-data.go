@@ -132,20 +132,57 @@ type GitLabEventData struct {
 }
 
 // KafkaEventData represents the event data generated by the Kafka gateway.
+// Key, Offset, Headers and ConsumerGroup are the data-contract side of
+// joining a sarama consumer group with ack-driven offset commit and
+// rebalance-safe shutdown; that gateway-side rework is not present in this
+// tree.
 type KafkaEventData struct {
 	// Topic refers to the Kafka topic
 	Topic string `json:"topic"`
 	// Partition refers to the Kafka partition
 	Partition int `json:"partition"`
+	// Key refers to the message key
+	Key []byte `json:"key"`
 	// Body refers to the message value
 	Body []byte `json:"value"`
+	// Offset refers to the message's offset within its partition
+	Offset int64 `json:"offset"`
+	// Headers refers to the message headers
+	Headers map[string][]byte `json:"headers"`
+	// ConsumerGroup is the name of the consumer group the gateway joined to
+	// receive this message
+	ConsumerGroup string `json:"consumerGroup"`
 	// Timestamp of the message
 	Timestamp string `json:"timestamp"`
 }
 
 // MinioEventData represents the event data generated by the Minio gateway.
+// It mirrors the shape of the MinIO server's own bucket notification records
+// (event.Log{EventName, Key, Records}) rather than exposing the raw
+// notification list, so sensors can trigger on precise object lifecycle
+// events; NotificationFilter.Match evaluates prefix, suffix and event-name
+// rules against such an event, but no gateway in this tree calls it before
+// dispatch. EventName, Key,
+// EventTime, RequestPrincipal and ResponseElements are promoted from
+// Records[0] to the top level for convenience; the full records, including
+// every entry when a single notification batches more than one, remain
+// available in Records.
 type MinioEventData struct {
-	Notification []minio.NotificationEvent `json:"notification"`
+	// EventName is the S3 event name, e.g. s3:ObjectCreated:Put.
+	EventName string `json:"eventName"`
+	// Key is the bucket/object key the event pertains to, e.g. mybucket/a/b/c.
+	Key string `json:"key"`
+	// EventTime is the time the S3 event occurred, as reported by the server.
+	EventTime string `json:"eventTime"`
+	// RequestPrincipal is the principal ID of the requester that caused the
+	// event, i.e. Records[0].UserIdentity.PrincipalID.
+	RequestPrincipal string `json:"requestPrincipal"`
+	// ResponseElements holds the S3 response elements returned to the
+	// requester, i.e. Records[0].ResponseElements.
+	ResponseElements map[string]string `json:"responseElements"`
+	// Records holds the underlying S3-style notification records as emitted
+	// by the Minio server.
+	Records []minio.NotificationEvent `json:"records"`
 }
 
 // MQTTEventData represents the event data generated by the MQTT gateway.
@@ -164,6 +201,21 @@ type NATSEventData struct {
 	Subject string `json:"subject"`
 	// Message data.
 	Body []byte `json:"data"`
+	// Headers refers to the message headers. Only populated in JetStream mode.
+	// +optional
+	Headers map[string][]string `json:"headers,omitempty"`
+	// Stream is the name of the JetStream stream the message was read from.
+	// Only populated in JetStream mode.
+	// +optional
+	Stream string `json:"stream,omitempty"`
+	// Sequence is the message's sequence number within Stream. Only populated
+	// in JetStream mode.
+	// +optional
+	Sequence uint64 `json:"sequence,omitempty"`
+	// NumDelivered is the number of times this message has been delivered.
+	// Only populated in JetStream mode.
+	// +optional
+	NumDelivered uint64 `json:"numDelivered,omitempty"`
 }
 
 // NSQEventData represents the event data generated by the NSQ gateway.
@@ -206,4 +258,37 @@ type WebhookEventData struct {
 	Header http.Header `json:"header"`
 	// Body is http request body
 	Body *json.RawMessage `json:"body"`
+	// EventType is the discriminated event kind, populated from a configured
+	// header (e.g. X-GitHub-Event, Ce-Type) or a JSONPath into the body, so
+	// sensor filters can dispatch on event-kind without re-parsing headers.
+	// +optional
+	EventType string `json:"eventType,omitempty"`
+	// Timestamp is the RFC3339 time the gateway received the request. HTTP
+	// requests carry no intrinsic event time of their own, so this is
+	// assigned at receipt rather than sourced from the request itself.
+	// +optional
+	Timestamp string `json:"timestamp,omitempty"`
+}
+
+// SSEEventData represents the event data generated by the SSE (Server-Sent
+// Events) gateway, mirroring the standard SSE frame fields.
+//
+// This is the event-data contract only; the SSE gateway itself — opening a
+// text/event-stream connection, parsing frames, and auto-reconnecting with
+// the Last-Event-ID header and the server's retry: directive — is not
+// present in this tree.
+type SSEEventData struct {
+	// ID is the event ID from the frame's `id:` field, used to resume the
+	// stream via the Last-Event-ID request header on reconnect.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// Event is the event name from the frame's `event:` field.
+	// +optional
+	Event string `json:"event,omitempty"`
+	// Data is the payload from the frame's `data:` field(s).
+	Data string `json:"data"`
+	// Retry is the reconnection time in milliseconds from the frame's
+	// `retry:` field, if the server sent one.
+	// +optional
+	Retry int `json:"retry,omitempty"`
 }
@@ -0,0 +1,71 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+import "strings"
+
+// NotificationFilter holds the prefix, suffix and event-name rules a Minio
+// gateway evaluates against a notification before dispatching a
+// MinioEventData, mirroring the filter rules supported by the MinIO server's
+// own bucket notification configuration.
+//
+// Match is the evaluation primitive; wiring it into the Minio gateway's
+// notification loop (calling Match per record and dropping notifications
+// that don't match before a MinioEventData is ever built) is not present in
+// this tree.
+// +k8s:openapi-gen=true
+type NotificationFilter struct {
+	// Prefix restricts matches to object keys with this prefix.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+	// Suffix restricts matches to object keys with this suffix.
+	// +optional
+	Suffix string `json:"suffix,omitempty"`
+	// Events is the allowlist of S3 event names to match, e.g.
+	// "s3:ObjectCreated:Put" or "s3:ObjectRemoved:*". An empty list matches
+	// every event name.
+	// +optional
+	Events []string `json:"events,omitempty"`
+}
+
+// Match returns true if key and eventName satisfy the filter's prefix, suffix
+// and event-name rules.
+func (f *NotificationFilter) Match(key, eventName string) bool {
+	if f.Prefix != "" && !strings.HasPrefix(key, f.Prefix) {
+		return false
+	}
+	if f.Suffix != "" && !strings.HasSuffix(key, f.Suffix) {
+		return false
+	}
+	if len(f.Events) == 0 {
+		return true
+	}
+	for _, allowed := range f.Events {
+		if eventNamePatternMatch(allowed, eventName) {
+			return true
+		}
+	}
+	return false
+}
+
+// eventNamePatternMatch matches eventName against a pattern that may end in a
+// "*" wildcard, e.g. "s3:ObjectRemoved:*" matches "s3:ObjectRemoved:Delete".
+func eventNamePatternMatch(pattern, eventName string) bool {
+	if strings.HasSuffix(pattern, "*") {
+		return strings.HasPrefix(eventName, strings.TrimSuffix(pattern, "*"))
+	}
+	return pattern == eventName
+}
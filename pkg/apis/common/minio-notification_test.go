@@ -0,0 +1,93 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+import "testing"
+
+func TestNotificationFilterMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    NotificationFilter
+		key       string
+		eventName string
+		want      bool
+	}{
+		{
+			name:      "no filter rules matches everything",
+			filter:    NotificationFilter{},
+			key:       "a/b/c.png",
+			eventName: "s3:ObjectCreated:Put",
+			want:      true,
+		},
+		{
+			name:      "prefix mismatch",
+			filter:    NotificationFilter{Prefix: "uploads/"},
+			key:       "downloads/a.png",
+			eventName: "s3:ObjectCreated:Put",
+			want:      false,
+		},
+		{
+			name:      "prefix match",
+			filter:    NotificationFilter{Prefix: "uploads/"},
+			key:       "uploads/a.png",
+			eventName: "s3:ObjectCreated:Put",
+			want:      true,
+		},
+		{
+			name:      "suffix mismatch",
+			filter:    NotificationFilter{Suffix: ".png"},
+			key:       "uploads/a.jpg",
+			eventName: "s3:ObjectCreated:Put",
+			want:      false,
+		},
+		{
+			name:      "exact event name match",
+			filter:    NotificationFilter{Events: []string{"s3:ObjectCreated:Put"}},
+			key:       "a.png",
+			eventName: "s3:ObjectCreated:Put",
+			want:      true,
+		},
+		{
+			name:      "event name not in allowlist",
+			filter:    NotificationFilter{Events: []string{"s3:ObjectCreated:Put"}},
+			key:       "a.png",
+			eventName: "s3:ObjectCreated:Copy",
+			want:      false,
+		},
+		{
+			name:      "wildcard event name match",
+			filter:    NotificationFilter{Events: []string{"s3:ObjectRemoved:*"}},
+			key:       "a.png",
+			eventName: "s3:ObjectRemoved:Delete",
+			want:      true,
+		},
+		{
+			name:      "prefix, suffix and event name all required",
+			filter:    NotificationFilter{Prefix: "uploads/", Suffix: ".png", Events: []string{"s3:ObjectCreated:*"}},
+			key:       "uploads/a.png",
+			eventName: "s3:ObjectCreated:Put",
+			want:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Match(tt.key, tt.eventName); got != tt.want {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.key, tt.eventName, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+// NATSDeliverPolicy determines from which point in a JetStream stream a
+// durable consumer starts receiving messages.
+type NATSDeliverPolicy string
+
+// Valid NATSDeliverPolicy values.
+const (
+	NATSDeliverAll             NATSDeliverPolicy = "all"
+	NATSDeliverLast            NATSDeliverPolicy = "last"
+	NATSDeliverNew             NATSDeliverPolicy = "new"
+	NATSDeliverByStartSequence NATSDeliverPolicy = "by-start-sequence"
+	NATSDeliverByStartTime     NATSDeliverPolicy = "by-start-time"
+)
+
+// NATSAckPolicy determines how a JetStream durable consumer acknowledges
+// delivered messages.
+type NATSAckPolicy string
+
+// Valid NATSAckPolicy values.
+const (
+	NATSAckNone     NATSAckPolicy = "none"
+	NATSAckAll      NATSAckPolicy = "all"
+	NATSAckExplicit NATSAckPolicy = "explicit"
+)
+
+// NATSJetStreamConfig configures a NATS gateway to consume from a JetStream
+// stream via a durable consumer instead of a fire-and-forget core NATS
+// subject, so events can be redelivered on gateway crash and replayed on
+// demand.
+//
+// This is the gateway-facing configuration shape only; the NATS gateway's
+// consume loop that reads it, subscribes with these settings and acks a
+// message only once the sensor dispatch it produced has been confirmed is
+// not present in this tree.
+// +k8s:openapi-gen=true
+type NATSJetStreamConfig struct {
+	// Stream is the name of the JetStream stream to consume from.
+	Stream string `json:"stream"`
+	// Durable is the name of the durable consumer. Reusing the same name
+	// across gateway restarts resumes delivery where it left off.
+	Durable string `json:"durable"`
+	// DeliverPolicy controls where in the stream the consumer starts.
+	// +optional
+	DeliverPolicy NATSDeliverPolicy `json:"deliverPolicy,omitempty"`
+	// AckPolicy controls how delivered messages are acknowledged.
+	// +optional
+	AckPolicy NATSAckPolicy `json:"ackPolicy,omitempty"`
+	// MaxAckPending is the maximum number of unacknowledged messages the
+	// server will allow in flight before pausing delivery.
+	// +optional
+	MaxAckPending int `json:"maxAckPending,omitempty"`
+	// FilterSubject restricts delivery to messages matching this subject
+	// within the stream.
+	// +optional
+	FilterSubject string `json:"filterSubject,omitempty"`
+}
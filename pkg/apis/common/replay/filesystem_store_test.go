@@ -0,0 +1,179 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package replay
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFilesystemStoreAppendAssignsSequencePerGateway(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := store.Append(Record{Gateway: "kafka-gateway"}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if err := store.Append(Record{Gateway: "minio-gateway"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := store.Query("kafka-gateway", time.Time{}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+	for i, r := range records {
+		if r.Sequence != uint64(i+1) {
+			t.Errorf("record %d sequence = %d, want %d", i, r.Sequence, i+1)
+		}
+	}
+
+	minioRecords, err := store.Query("minio-gateway", time.Time{}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(minioRecords) != 1 || minioRecords[0].Sequence != 1 {
+		t.Fatalf("minio-gateway sequence not independent from kafka-gateway: %+v", minioRecords)
+	}
+}
+
+func TestFilesystemStoreQueryFiltersByTimeRange(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		record := Record{Gateway: "webhook-gateway", Timestamp: base.Add(time.Duration(i) * time.Hour)}
+		if err := store.Append(record); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	records, err := store.Query("webhook-gateway", base.Add(time.Hour), base.Add(3*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("got %d records, want 3", len(records))
+	}
+	for _, r := range records {
+		if r.Timestamp.Before(base.Add(time.Hour)) || r.Timestamp.After(base.Add(3*time.Hour)) {
+			t.Errorf("record timestamp %v out of requested range", r.Timestamp)
+		}
+	}
+}
+
+func TestFilesystemStoreQueryUnknownGatewayReturnsEmpty(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := store.Query("nonexistent-gateway", time.Time{}, time.Now())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("got %d records, want 0", len(records))
+	}
+}
+
+// TestFilesystemStoreRejectsGatewayPathTraversal guards Append/Query against
+// an untrusted gateway name (e.g. from the replay admin endpoint's gateway=
+// query parameter) that tries to escape Dir.
+func TestFilesystemStoreRejectsGatewayPathTraversal(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.Append(Record{Gateway: "../../../etc/passwd"}); err == nil {
+		t.Fatalf("expected Append to reject a path-traversal gateway name")
+	}
+
+	if _, err := store.Query("../../../etc/passwd", time.Time{}, time.Now()); err == nil {
+		t.Fatalf("expected Query to reject a path-traversal gateway name")
+	}
+}
+
+// TestFilesystemStoreQueryHandlesLargeRecord exercises a Record whose
+// EventData exceeds bufio.Scanner's default ~64KB token size, which used to
+// make Query silently truncate the result set instead of reading the full
+// line.
+func TestFilesystemStoreQueryHandlesLargeRecord(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	large, err := json.Marshal(strings.Repeat("a", 128*1024))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := store.Append(Record{Gateway: "kafka-gateway", EventData: large}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records, err := store.Query("kafka-gateway", time.Time{}, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(records) != 1 {
+		t.Fatalf("got %d records, want 1", len(records))
+	}
+	if len(records[0].EventData) != len(large) {
+		t.Fatalf("got EventData of length %d, want %d", len(records[0].EventData), len(large))
+	}
+}
+
+// TestFilesystemStoreConcurrentAppendAndQuery guards against Query observing
+// a partially written line from a concurrent Append.
+func TestFilesystemStoreConcurrentAppendAndQuery(t *testing.T) {
+	store, err := NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_ = store.Append(Record{Gateway: "kafka-gateway", Timestamp: time.Now()})
+		}()
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := store.Query("kafka-gateway", time.Time{}, time.Now().Add(time.Hour)); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
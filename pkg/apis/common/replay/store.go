@@ -0,0 +1,74 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package replay persists every EventData a gateway dispatches so it can
+// later be replayed or backfilled into a sensor, e.g. for disaster recovery
+// or to test a new sensor against historical traffic. A caller appends a
+// Record per dispatched event to a Store and can later query the store for a
+// time range to re-emit the matching records to sensor endpoints. This
+// package holds the Store interface and FilesystemStore; no gateway in this
+// tree appends to a Store or serves a replay endpoint.
+package replay
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// Record is a single persisted event plus the bookkeeping fields the replay
+// subsystem needs to locate and reorder it later.
+type Record struct {
+	// Sequence is a monotonically increasing number assigned at append time,
+	// unique per gateway.
+	Sequence uint64 `json:"sequence"`
+	// Gateway is the name of the gateway that produced the event.
+	Gateway string `json:"gateway"`
+	// Timestamp is the event's timestamp as set by the caller (for example
+	// from common.Timestamped); FilesystemStore.Append sets it to the time it
+	// was appended when it is left zero.
+	Timestamp time.Time `json:"timestamp"`
+	// EventData is the raw, gateway-specific event payload as dispatched to
+	// sensors.
+	EventData json.RawMessage `json:"eventData"`
+}
+
+// Store persists dispatched events as an append-only log and allows range
+// queries over them for replay/backfill. gateway is expected to arrive as an
+// untrusted query parameter on the replay admin endpoint, so implementations
+// must validate it with ValidateGatewayName (or equivalent) before using it
+// to build a file path, object key, or similar, rather than leaving that to
+// whoever wires up the HTTP handler.
+type Store interface {
+	// Append persists record, assigning it the next sequence number for its
+	// gateway.
+	Append(record Record) error
+	// Query returns every record for gateway whose timestamp falls within
+	// [from, to], in the order they were appended.
+	Query(gateway string, from, to time.Time) ([]Record, error)
+}
+
+// ValidateGatewayName returns an error if name is not safe to use as a
+// single filesystem path component or object key segment, e.g. because it
+// embeds a path separator or is "." or "..". Store implementations that
+// derive a location from a gateway name must call this before doing so.
+func ValidateGatewayName(name string) error {
+	if name == "" || name == "." || name == ".." || filepath.Base(name) != name {
+		return fmt.Errorf("replay: invalid gateway name %q", name)
+	}
+	return nil
+}
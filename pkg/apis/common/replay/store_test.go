@@ -0,0 +1,45 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package replay
+
+import "testing"
+
+func TestValidateGatewayName(t *testing.T) {
+	tests := []struct {
+		name    string
+		wantErr bool
+	}{
+		{"kafka-gateway", false},
+		{"minio-gateway-01", false},
+		{"", true},
+		{".", true},
+		{"..", true},
+		{"../etc", true},
+		{"../../etc/passwd", true},
+		{"foo/bar", true},
+		{"/etc/passwd", true},
+		{"foo/../../bar", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGatewayName(tt.name)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateGatewayName(%q) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			}
+		})
+	}
+}
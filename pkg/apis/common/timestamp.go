@@ -0,0 +1,51 @@
+/*
+Copyright 2020 BlackRock, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package common
+
+// Timestamped is implemented by EventData types that carry a natural
+// point-in-time at which the underlying source event occurred. It lets
+// gateway-agnostic code, such as the replay subsystem, order and filter
+// stored events without knowing each gateway's field names.
+//
+// Note this only normalizes an existing timestamp field; it does not assign
+// replay.Record.Sequence, which remains a property of the replay store
+// rather than of the EventData itself.
+type Timestamped interface {
+	// EventTimestamp returns the RFC3339 timestamp of the source event, or
+	// the empty string if the EventData carries no natural timestamp.
+	EventTimestamp() string
+}
+
+// EventTimestamp implements Timestamped.
+func (e AMQPEventData) EventTimestamp() string { return e.Timestamp }
+
+// EventTimestamp implements Timestamped.
+func (e KafkaEventData) EventTimestamp() string { return e.Timestamp }
+
+// EventTimestamp implements Timestamped.
+func (e PubSubEventData) EventTimestamp() string { return e.PublishTime }
+
+// EventTimestamp implements Timestamped.
+func (e CalendarEventData) EventTimestamp() string { return e.EventTime }
+
+// EventTimestamp implements Timestamped.
+func (e NSQEventData) EventTimestamp() string { return e.Timestamp }
+
+// EventTimestamp implements Timestamped.
+func (e WebhookEventData) EventTimestamp() string { return e.Timestamp }
+
+// EventTimestamp implements Timestamped.
+func (e MinioEventData) EventTimestamp() string { return e.EventTime }